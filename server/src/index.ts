@@ -38,7 +38,7 @@ import {
   bulkInsertExamples,
   searchExamples,
 } from './db/db_es.js';
-import { authenticate, authenticateWithJwtFromHeader, AuthenticatedRequest, checkAccess } from './server_auth.js';
+import { authenticate, authenticateWithJwtFromHeader, AuthenticatedRequest, checkAccess, getQueryOrganisation } from './server_auth.js';
 import SearchQuery from './common/SearchQuery.js';
 import Example from './common/types/Example.js';
 import { registerExperimentRoutes } from './routes/experiments.js';
@@ -318,7 +318,7 @@ fastify.get('/api-key/:id', {preHandler: authenticate}, async (request: Authenti
 // Security: Authenticated users only. Organisation membership verified by authenticate middleware. Results filtered by organisationId in database (listApiKeys).
 fastify.get('/api-key', { preHandler: authenticate }, async (request: AuthenticatedRequest, reply) => {
   if (!checkAccess(request, reply, ['developer', 'admin'])) return;
-  const organisationId = (request.query as any).organisation as string | undefined;
+  const organisationId = getQueryOrganisation(request);
   if (!organisationId) {
     reply.code(400).send({ error: 'organisation query parameter is required' });
     return;
@@ -354,15 +354,16 @@ fastify.delete('/api-key/:id', { preHandler: authenticate }, async (request: Aut
 });
 
 // ===== DATASET ENDPOINTS (PostgreSQL) =====
-// Security: Authenticated users only. Organisation membership verified by authenticate middleware when organisation query param provided.
+// Security: Authenticated users only. Organisation membership (or API key organisation) verified by authenticate middleware. Dataset stored under that organisation.
 fastify.post('/dataset', { preHandler: authenticate }, async (request: AuthenticatedRequest, reply) => {
 	if (!checkAccess(request, reply, ['developer', 'admin'])) return;
-	const organisationId = (request.query as any).organisation as string | undefined;
+	const organisationId = getQueryOrganisation(request);
   if (!organisationId) {
     reply.code(400).send({ error: 'organisation query parameter is required' });
     return;
   }
-  const dataset = await createDataset(request.body as any);
+  // Store under the checked organisation, not whatever the body claims
+  const dataset = await createDataset({ ...(request.body as any), organisation: organisationId });
   return dataset;
 });
 
@@ -381,7 +382,7 @@ fastify.get('/dataset/:id', { preHandler: authenticate }, async (request: Authen
 // Security: Authenticated users only. Organisation membership verified by authenticate middleware. Results filtered by organisationId in database (listDatasets).
 fastify.get('/dataset', { preHandler: authenticate }, async (request: AuthenticatedRequest, reply) => {
   if (!checkAccess(request, reply, ['developer', 'admin'])) return;
-  const organisationId = (request.query as any).organisation as string | undefined;
+  const organisationId = getQueryOrganisation(request);
   if (!organisationId) {
     reply.code(400).send({ error: 'organisation query parameter is required' });
     return;
@@ -466,7 +467,7 @@ fastify.post('/example', { preHandler: authenticate }, async (request: Authentic
 // Security: Authenticated users only. Organisation membership verified by authenticate middleware. Results filtered by organisationId in Elasticsearch (searchExamples).
 fastify.get('/example', { preHandler: authenticate }, async (request: AuthenticatedRequest, reply) => {
   if (!checkAccess(request, reply, ['developer', 'admin'])) return;
-  const organisationId = (request.query as any).organisation as string | undefined;
+  const organisationId = getQueryOrganisation(request);
   if (!organisationId) {
     reply.code(400).send({ error: 'organisation query parameter is required' });
     return;
@@ -8,7 +8,7 @@ import {
   getDataset,
 } from '../db/db_sql.js';
 import { searchExamples } from '../db/db_es.js';
-import { authenticate, AuthenticatedRequest, checkAccess } from '../server_auth.js';
+import { authenticate, AuthenticatedRequest, checkAccess, getQueryOrganisation } from '../server_auth.js';
 import SearchQuery from '../common/SearchQuery.js';
 import { scoreMetric } from '../scoring.js';
 
@@ -177,7 +177,7 @@ export async function registerExperimentRoutes(fastify: FastifyInstance): Promis
   // Security: Authenticated users only. Organisation membership verified by authenticate middleware. Results filtered by organisationId in database (listExperiments).
   fastify.get('/experiment', { preHandler: authenticate }, async (request: AuthenticatedRequest, reply) => {
     if (!checkAccess(request, reply, ['developer', 'admin'])) return;
-    const organisationId = (request.query as any).organisation as string | undefined;
+    const organisationId = getQueryOrganisation(request);
     if (!organisationId) {
       reply.code(400).send({ error: 'organisation query parameter is required' });
       return;
@@ -1,6 +1,6 @@
 import { FastifyInstance } from 'fastify';
 import { bulkInsertSpans, searchSpans, updateSpan } from '../db/db_es.js';
-import { authenticate, AuthenticatedRequest, checkAccess } from '../server_auth.js';
+import { authenticate, AuthenticatedRequest, checkAccess, getQueryOrganisation } from '../server_auth.js';
 import SearchQuery from '../common/SearchQuery.js';
 import Span from '../common/types/Span.js';
 import { addTokenCost } from '../token_cost.js';
@@ -46,7 +46,7 @@ export async function registerSpanRoutes(fastify: FastifyInstance): Promise<void
    */
   fastify.get('/span', { preHandler: authenticate }, async (request: AuthenticatedRequest, reply) => {
     if (!checkAccess(request, reply, ['developer', 'admin'])) return;
-    const organisationId = (request.query as any).organisation as string | undefined;
+    const organisationId = getQueryOrganisation(request);
     if (!organisationId) {
       reply.code(400).send({ error: 'organisation query parameter is required' });
       return;
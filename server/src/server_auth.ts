@@ -145,6 +145,12 @@ async function authenticateWithApiKey(
 
 	// Look up user from API key's organisation (API keys are tied to organisations)
 	// For API keys, we use the organisation directly from the API key record
+	// An API key can only act on its own organisation - reject requests for any other
+	const requestedOrg = (request.query as any)?.organisation;
+	if (requestedOrg && requestedOrg !== apiKey.organisation) {
+		reply.code(403).send({ error: 'API key does not belong to the specified organisation' });
+		return false;
+	}
 	request.organisation = apiKey.organisation;
 	request.apiKeyId = apiKey.id;
 	request.apiKey = apiKey; // Store full API key for permission checks
@@ -261,6 +267,7 @@ export async function authenticateWithJwtFromHeader(request: FastifyRequest): Pr
  * 
  * Optional: JWT requests can include organisationId as query parameter or X-Organisation-Id header
  * to specify which organisation to use (user must be a member).
+ * API key requests may include the organisation query parameter, but it must match the key's organisation.
  * 
  * On success, attaches organisationId, userId (for JWT), and apiKeyId (for API key) to request.
  * On failure, sends 401/403 response and does not call next handler.
@@ -351,6 +358,24 @@ export function checkAccess(request: AuthenticatedRequest, reply: FastifyReply,
 	return true;
 }
 
+/**
+ * Get the organisation to scope a list/search to.
+ * Uses the organisation query parameter (already checked by authenticate middleware).
+ * API keys are tied to one organisation, so they default to it when the parameter is omitted.
+ *
+ * @returns organisation ID, or undefined if none was given (JWT requests must specify one)
+ */
+export function getQueryOrganisation(request: AuthenticatedRequest): string | undefined {
+	const organisationId = (request.query as any).organisation as string | undefined;
+	if (organisationId) {
+		return organisationId;
+	}
+	if (request.authenticatedWith === 'api_key') {
+		return request.organisation;
+	}
+	return undefined;
+}
+
 /**
  * Legacy function name for backward compatibility.
  * @deprecated Use authenticate() instead.